@@ -0,0 +1,72 @@
+package linkedlist
+
+import "iter"
+
+// Chain is a lazily-evaluated pipeline of Map/Filter transforms over a
+// LinkedList. Unlike the eager Map/Filter methods, a Chain fuses every
+// transform into a single pass over the list, allocating no intermediate
+// lists, and only walks the source once a terminal method (ForEach, Slice or
+// Reduce) is called.
+type Chain[T any] struct {
+	seq iter.Seq[T]
+}
+
+// Seq will return a Chain wrapping the values of the linked list
+func (l *LinkedList[T]) Seq() Chain[T] {
+	return Chain[T]{seq: l.Values()}
+}
+
+// Map will return a Chain which lazily applies fn to every value
+func (c Chain[T]) Map(fn MapFn[T]) Chain[T] {
+	return Chain[T]{seq: func(yield func(T) bool) {
+		for val := range c.seq {
+			if !yield(fn(val)) {
+				return
+			}
+		}
+	}}
+}
+
+// Filter will return a Chain which lazily skips every value fn rejects
+func (c Chain[T]) Filter(fn FilterFn[T]) Chain[T] {
+	return Chain[T]{seq: func(yield func(T) bool) {
+		for val := range c.seq {
+			if fn(val) && !yield(val) {
+				return
+			}
+		}
+	}}
+}
+
+// Reduce will walk the chain once, folding every value into an accumulator.
+// The accumulator shares the value type T rather than introducing a second
+// type parameter, since methods (unlike LinkedList's package-level Reduce)
+// cannot add type parameters beyond those of their receiver.
+func (c Chain[T]) Reduce(fn ChainReduceFn[T]) (sum T) {
+	for val := range c.seq {
+		sum = fn(sum, val)
+	}
+
+	return
+}
+
+// ForEach will walk the chain once, calling fn for every value
+func (c Chain[T]) ForEach(fn func(val T) (end bool)) {
+	for val := range c.seq {
+		if fn(val) {
+			return
+		}
+	}
+}
+
+// Slice will walk the chain once, collecting every value into a slice
+func (c Chain[T]) Slice() (s []T) {
+	for val := range c.seq {
+		s = append(s, val)
+	}
+
+	return
+}
+
+// ChainReduceFn is the format of the function used to call Chain.Reduce
+type ChainReduceFn[T any] func(acc, val T) (sum T)
@@ -0,0 +1,34 @@
+package linkedlist
+
+import "iter"
+
+// Values will return an iterator over the values within the linked list, in
+// order, for use with a range statement (e.g. for v := range l.Values()).
+func (l *LinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		l.ForEach(nil, func(_ *Node[T], val T) bool {
+			return !yield(val)
+		})
+	}
+}
+
+// All will return an iterator over the nodes and values within the linked
+// list, in order, for use with a range statement (e.g. for n, v := range
+// l.All()).
+func (l *LinkedList[T]) All() iter.Seq2[*Node[T], T] {
+	return func(yield func(*Node[T], T) bool) {
+		l.ForEach(nil, func(n *Node[T], val T) bool {
+			return !yield(n, val)
+		})
+	}
+}
+
+// Backward will return an iterator over the nodes and values within the
+// linked list, in reverse order.
+func (l *LinkedList[T]) Backward() iter.Seq2[*Node[T], T] {
+	return func(yield func(*Node[T], T) bool) {
+		l.ForEachRev(nil, func(n *Node[T], val T) bool {
+			return !yield(n, val)
+		})
+	}
+}
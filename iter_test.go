@@ -0,0 +1,67 @@
+package linkedlist
+
+import "testing"
+
+func TestLinkedListValues(t *testing.T) {
+	var l LinkedList[int]
+	l.Append(0, 1, 2, 3, 4)
+
+	var got []int
+	for v := range l.Values() {
+		got = append(got, v)
+	}
+
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("invalid value at index %d, expected %d and received %d", i, i, v)
+		}
+	}
+}
+
+func TestLinkedListAllAndBackward(t *testing.T) {
+	var l LinkedList[int]
+	l.Append(0, 1, 2, 3, 4)
+
+	var forward []int
+	for n, v := range l.All() {
+		if l.Val(n) != v {
+			t.Fatalf("node/value mismatch, expected %d and received %d", l.Val(n), v)
+		}
+
+		forward = append(forward, v)
+	}
+
+	var backward []int
+	for _, v := range l.Backward() {
+		backward = append(backward, v)
+	}
+
+	if len(forward) != len(backward) {
+		t.Fatalf("invalid length, expected %d and received %d", len(forward), len(backward))
+	}
+
+	for i, v := range backward {
+		ev := forward[len(forward)-1-i]
+		if v != ev {
+			t.Fatalf("invalid value at index %d, expected %d and received %d", i, ev, v)
+		}
+	}
+}
+
+func TestChain(t *testing.T) {
+	var l LinkedList[int]
+	l.Append(0, 1, 2, 3, 4, 5, 6)
+
+	sum := l.Seq().Map(testAddOne).Filter(testIsEven).Reduce(func(acc, val int) int {
+		return acc + val
+	})
+
+	if sum != 12 {
+		t.Fatalf("expected %v and received %v", 12, sum)
+	}
+
+	slice := l.Seq().Filter(testIsEven).Slice()
+	if len(slice) != 4 {
+		t.Fatalf("invalid length, expected %v and received %v", 4, len(slice))
+	}
+}
@@ -1,36 +1,71 @@
 package linkedlist
 
-//go:generate genny -in=$GOFILE -out=typed/int/$GOFILE gen "GenericVal=int GenericSum=int"
-//go:generate genny -in=$GOFILE -out=typed/int32/$GOFILE gen "GenericVal=int32 GenericSum=int32"
-//go:generate genny -in=$GOFILE -out=typed/int64/$GOFILE gen "GenericVal=int64 GenericSum=int64"
-//go:generate genny -in=$GOFILE -out=typed/string/$GOFILE gen "GenericVal=string GenericSum=string"
-//go:generate genny -in=$GOFILE -out=typed/byteslice/$GOFILE gen "GenericVal=[]byte GenericSum=[]byte"
+import "sync"
 
-import "github.com/cheekybits/genny/generic"
+// LinkedList is a simple doubly-linked list
+type LinkedList[T any] struct {
+	head *Node[T]
+	tail *Node[T]
+
+	mu   sync.RWMutex
+	pool sync.Pool
 
-var (
-	zeroVal GenericVal
-	zeroSum GenericSum
-)
+	reporter   bool
+	concurrent bool
+	len        int32
+}
 
-// GenericVal is a generic value type
-type GenericVal generic.Type
+// NewConcurrent will return a new LinkedList which is safe for concurrent
+// use. Mutating operations take a write lock and reads take a read lock; use
+// this when the list is shared across goroutines.
+func NewConcurrent[T any]() *LinkedList[T] {
+	return &LinkedList[T]{concurrent: true}
+}
 
-// GenericSum is a generic sum type used for reducing
-type GenericSum generic.Type
+// getNode will fetch a node from the pool, populating it with the provided
+// links and value. Concurrent lists opt out of pooling: ForEach hands out a
+// snapshot of node pointers after releasing its lock, so a removed node may
+// still be held by another goroutine's in-flight snapshot. Recycling that
+// address into an unrelated Append/Prepend would let the stale holder's
+// Remove call unlink live, unrelated data.
+func (l *LinkedList[T]) getNode(prev, next *Node[T], val T) (n *Node[T]) {
+	if l.concurrent {
+		return &Node[T]{prev: prev, next: next, val: val}
+	}
 
-// LinkedList is a simple doubly-linked list
-type LinkedList struct {
-	head *Node
-	tail *Node
+	if l.pool.New == nil {
+		l.pool.New = newPooledNode[T]
+	}
+
+	n = l.pool.Get().(*Node[T])
+	n.prev = prev
+	n.next = next
+	n.val = val
+	return
+}
+
+// putNode will zero a node and, for non-concurrent lists, return it to the
+// pool. See getNode for why concurrent lists never recycle nodes.
+func (l *LinkedList[T]) putNode(n *Node[T]) {
+	var zero T
+	n.prev = nil
+	n.next = nil
+	n.val = zero
+
+	if l.concurrent {
+		return
+	}
+
+	l.pool.Put(n)
+}
 
-	reporter bool
-	len      int32
+func newPooledNode[T any]() interface{} {
+	return &Node[T]{}
 }
 
 // prepend will prepend the list with a value, the reference node is Returned
-func (l *LinkedList) prepend(val GenericVal) (n *Node) {
-	n = newNode(nil, l.head, val)
+func (l *LinkedList[T]) prepend(val T) (n *Node[T]) {
+	n = l.getNode(nil, l.head, val)
 
 	if l.head != nil {
 		// Head exists, set the previous value to our new node
@@ -50,8 +85,8 @@ func (l *LinkedList) prepend(val GenericVal) (n *Node) {
 }
 
 // append will append the list with a value, the reference node is Returned
-func (l *LinkedList) append(val GenericVal) (n *Node) {
-	n = newNode(l.tail, nil, val)
+func (l *LinkedList[T]) append(val T) (n *Node[T]) {
+	n = l.getNode(l.tail, nil, val)
 
 	if l.tail != nil {
 		// Tail exists, set the next value to our new node
@@ -70,82 +105,60 @@ func (l *LinkedList) append(val GenericVal) (n *Node) {
 	return
 }
 
-// mapCopy will return a copied and mapped list
-func (l *LinkedList) mapCopy(fn MapFn) (nl *LinkedList) {
-	nl = &LinkedList{reporter: true}
-	// Iterate through each item
-	l.ForEach(nil, func(n *Node, val GenericVal) bool {
-		nl.append(fn(val))
-		return false
-	})
-
-	return
-}
-
-// mapModify will return a copied and mapped list
-func (l *LinkedList) mapModify(fn MapFn) (nl *LinkedList) {
-	nl = l
-	// Iterate through each item
-	l.ForEach(nil, func(n *Node, val GenericVal) bool {
-		n.val = fn(val)
-		return false
-	})
-
-	return
-}
-
-// filterCopy will return a copied and filtered list
-func (l *LinkedList) filterCopy(fn FilterFn) (nl *LinkedList) {
-	nl = &LinkedList{reporter: true}
-	// Iterate through each item
-	l.ForEach(nil, func(_ *Node, val GenericVal) bool {
-		if fn(val) {
-			nl.append(val)
+// prependBulk will splice a contiguous run of nodes built from vals onto the
+// front of the list in a single pass, values kept in the same order Prepend
+// would have produced by calling prepend once per value.
+func (l *LinkedList[T]) prependBulk(vals []T) {
+	nodes := make([]Node[T], len(vals))
+	last := len(vals) - 1
+	for i := range nodes {
+		nodes[i].val = vals[last-i]
+		if i > 0 {
+			nodes[i].prev = &nodes[i-1]
+			nodes[i-1].next = &nodes[i]
 		}
+	}
 
-		return false
-	})
+	newHead, newTail := &nodes[0], &nodes[last]
+	if l.head != nil {
+		l.head.prev = newTail
+		newTail.next = l.head
+	} else {
+		l.tail = newTail
+	}
 
-	return
+	l.head = newHead
+	l.len += int32(len(vals))
 }
 
-// filterModify will modify and return filtered list
-func (l *LinkedList) filterModify(fn FilterFn) (nl *LinkedList) {
-	nl = l
-	// Iterate through each item
-	l.ForEach(nil, func(n *Node, val GenericVal) bool {
-		if !fn(val) {
-			l.Remove(n)
+// appendBulk will splice a contiguous run of nodes built from vals onto the
+// back of the list in a single pass
+func (l *LinkedList[T]) appendBulk(vals []T) {
+	nodes := make([]Node[T], len(vals))
+	last := len(vals) - 1
+	for i := range nodes {
+		nodes[i].val = vals[i]
+		if i > 0 {
+			nodes[i].prev = &nodes[i-1]
+			nodes[i-1].next = &nodes[i]
 		}
-
-		return false
-	})
-
-	return
-}
-
-// Prepend will prepend the list with a value, the reference Node is Returned
-func (l *LinkedList) Prepend(vals ...GenericVal) {
-	// Iterate through provided values
-	for _, val := range vals {
-		l.prepend(val)
 	}
 
-	return
-}
-
-// Append will append the list with a value, the reference Node is Returned
-func (l *LinkedList) Append(vals ...GenericVal) {
-	// Iterate through provided values
-	for _, val := range vals {
-		l.append(val)
+	newHead, newTail := &nodes[0], &nodes[last]
+	if l.tail != nil {
+		l.tail.next = newHead
+		newHead.prev = l.tail
+	} else {
+		l.head = newHead
 	}
 
-	return
+	l.tail = newTail
+	l.len += int32(len(vals))
 }
 
-// Remove will remove a node from a list
-func (l *LinkedList) Remove(n *Node) {
+// remove will remove a node from a list and, for non-concurrent lists,
+// return it to the pool
+func (l *LinkedList[T]) remove(n *Node[T]) {
 	if n.prev != nil {
 		// Set previous node's next as our current next node
 		n.prev.next = n.next
@@ -170,23 +183,21 @@ func (l *LinkedList) Remove(n *Node) {
 		}
 	}
 
-	// Set node to zero values
-	n.prev = nil
-	n.next = nil
-	n.val = zeroVal
 	// Decrement node count
 	l.len--
+	// Return the node to the pool
+	l.putNode(n)
 }
 
-// ForEach will iterate through each node within the linked list
-func (l *LinkedList) ForEach(n *Node, fn ForEachFn) (ended bool) {
+// forEach will iterate through each node within the linked list
+func (l *LinkedList[T]) forEach(n *Node[T], fn ForEachFn[T]) (ended bool) {
 	if n == nil {
 		// Provided node is nil, set to head
 		n = l.head
 	}
 
 	// Next node
-	var nn *Node
+	var nn *Node[T]
 	// Iterate until n equals nil
 	for n != nil {
 		// Set next node
@@ -204,15 +215,15 @@ func (l *LinkedList) ForEach(n *Node, fn ForEachFn) (ended bool) {
 	return false
 }
 
-// ForEachRev will iterate through each node within the linked list in reverse
-func (l *LinkedList) ForEachRev(n *Node, fn ForEachFn) (ended bool) {
+// forEachRev will iterate through each node within the linked list in reverse
+func (l *LinkedList[T]) forEachRev(n *Node[T], fn ForEachFn[T]) (ended bool) {
 	if n == nil {
 		// Provided node is nil, set to tail
 		n = l.tail
 	}
 
 	// Previous node
-	var pn *Node
+	var pn *Node[T]
 	// Iterate until n equals nil
 	for n != nil {
 		// Set previous node
@@ -230,8 +241,212 @@ func (l *LinkedList) ForEachRev(n *Node, fn ForEachFn) (ended bool) {
 	return false
 }
 
+// mapCopy will return a copied and mapped list
+func (l *LinkedList[T]) mapCopy(fn MapFn[T]) (nl *LinkedList[T]) {
+	nl = &LinkedList[T]{reporter: true, concurrent: l.concurrent}
+	// Iterate through each item
+	l.forEach(nil, func(n *Node[T], val T) bool {
+		nl.append(fn(val))
+		return false
+	})
+
+	return
+}
+
+// mapModify will return a copied and mapped list
+func (l *LinkedList[T]) mapModify(fn MapFn[T]) (nl *LinkedList[T]) {
+	nl = l
+	// Iterate through each item
+	l.forEach(nil, func(n *Node[T], val T) bool {
+		n.val = fn(val)
+		return false
+	})
+
+	return
+}
+
+// filterCopy will return a copied and filtered list
+func (l *LinkedList[T]) filterCopy(fn FilterFn[T]) (nl *LinkedList[T]) {
+	nl = &LinkedList[T]{reporter: true, concurrent: l.concurrent}
+	// Iterate through each item
+	l.forEach(nil, func(_ *Node[T], val T) bool {
+		if fn(val) {
+			nl.append(val)
+		}
+
+		return false
+	})
+
+	return
+}
+
+// filterModify will modify and return filtered list
+func (l *LinkedList[T]) filterModify(fn FilterFn[T]) (nl *LinkedList[T]) {
+	nl = l
+	// Iterate through each item
+	l.forEach(nil, func(n *Node[T], val T) bool {
+		if !fn(val) {
+			l.remove(n)
+		}
+
+		return false
+	})
+
+	return
+}
+
+// Prepend will prepend the list with the provided values. A single value
+// goes through the pooled prepend path; two or more are wired into a single
+// contiguous run and spliced onto the head in one pass, rather than
+// allocating one node per value.
+func (l *LinkedList[T]) Prepend(vals ...T) {
+	if len(vals) == 0 {
+		return
+	}
+
+	if l.concurrent {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+	}
+
+	if len(vals) == 1 {
+		l.prepend(vals[0])
+		return
+	}
+
+	l.prependBulk(vals)
+	return
+}
+
+// Append will append the list with the provided values. A single value goes
+// through the pooled append path; two or more are wired into a single
+// contiguous run and spliced onto the tail in one pass, rather than
+// allocating one node per value.
+func (l *LinkedList[T]) Append(vals ...T) {
+	if len(vals) == 0 {
+		return
+	}
+
+	if l.concurrent {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+	}
+
+	if len(vals) == 1 {
+		l.append(vals[0])
+		return
+	}
+
+	l.appendBulk(vals)
+	return
+}
+
+// Reset will empty the list. For non-concurrent lists every node is returned
+// to the pool for reuse; concurrent lists do not pool nodes.
+func (l *LinkedList[T]) Reset() {
+	if l.concurrent {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+	}
+
+	l.reset()
+}
+
+// reset will empty the list, handing every node to putNode, which pools it
+// for non-concurrent lists
+func (l *LinkedList[T]) reset() {
+	for n := l.head; n != nil; {
+		nn := n.next
+		l.putNode(n)
+		n = nn
+	}
+
+	l.head = nil
+	l.tail = nil
+	l.len = 0
+}
+
+// Remove will remove a node from a list
+func (l *LinkedList[T]) Remove(n *Node[T]) {
+	if l.concurrent {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+	}
+
+	l.remove(n)
+}
+
+// ForEach will iterate through each node within the linked list. If the list
+// is concurrent, the nodes to visit are snapshotted under the read lock and
+// the lock is released before fn is invoked, so fn may safely call back into
+// the list (e.g. Remove) without deadlocking.
+func (l *LinkedList[T]) ForEach(n *Node[T], fn ForEachFn[T]) (ended bool) {
+	if !l.concurrent {
+		return l.forEach(n, fn)
+	}
+
+	type snapshot struct {
+		n   *Node[T]
+		val T
+	}
+
+	l.mu.RLock()
+	if n == nil {
+		n = l.head
+	}
+	snap := make([]snapshot, 0, l.len)
+	for cur := n; cur != nil; cur = cur.next {
+		snap = append(snap, snapshot{cur, cur.val})
+	}
+	l.mu.RUnlock()
+
+	for _, s := range snap {
+		if fn(s.n, s.val) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ForEachRev will iterate through each node within the linked list in
+// reverse. See ForEach for the concurrent snapshotting behavior.
+func (l *LinkedList[T]) ForEachRev(n *Node[T], fn ForEachFn[T]) (ended bool) {
+	if !l.concurrent {
+		return l.forEachRev(n, fn)
+	}
+
+	type snapshot struct {
+		n   *Node[T]
+		val T
+	}
+
+	l.mu.RLock()
+	if n == nil {
+		n = l.tail
+	}
+	snap := make([]snapshot, 0, l.len)
+	for cur := n; cur != nil; cur = cur.prev {
+		snap = append(snap, snapshot{cur, cur.val})
+	}
+	l.mu.RUnlock()
+
+	for _, s := range snap {
+		if fn(s.n, s.val) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Map will return a mapped list
-func (l *LinkedList) Map(fn MapFn) (nl *LinkedList) {
+func (l *LinkedList[T]) Map(fn MapFn[T]) (nl *LinkedList[T]) {
+	if l.concurrent {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+	}
+
 	if l.reporter {
 		return l.mapModify(fn)
 	}
@@ -240,7 +455,12 @@ func (l *LinkedList) Map(fn MapFn) (nl *LinkedList) {
 }
 
 // Filter will return a filtered list
-func (l *LinkedList) Filter(fn FilterFn) (nl *LinkedList) {
+func (l *LinkedList[T]) Filter(fn FilterFn[T]) (nl *LinkedList[T]) {
+	if l.concurrent {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+	}
+
 	if l.reporter {
 		return l.filterModify(fn)
 	}
@@ -248,10 +468,12 @@ func (l *LinkedList) Filter(fn FilterFn) (nl *LinkedList) {
 	return l.filterCopy(fn)
 }
 
-// Reduce will return a reduced value
-func (l *LinkedList) Reduce(fn ReduceFn) (sum GenericSum) {
+// Reduce will return a reduced value. It is a package-level function rather
+// than a method because methods cannot introduce the additional type
+// parameter S needed for the accumulator.
+func Reduce[T, S any](l *LinkedList[T], fn ReduceFn[T, S]) (sum S) {
 	// Iterate through each item
-	l.ForEach(nil, func(_ *Node, val GenericVal) bool {
+	l.ForEach(nil, func(_ *Node[T], val T) bool {
 		sum = fn(sum, val)
 		return false
 	})
@@ -260,9 +482,14 @@ func (l *LinkedList) Reduce(fn ReduceFn) (sum GenericSum) {
 }
 
 // Slice will return a slice of the current linked list
-func (l *LinkedList) Slice() (s []GenericVal) {
-	s = make([]GenericVal, 0, l.len)
-	l.ForEach(nil, func(_ *Node, val GenericVal) bool {
+func (l *LinkedList[T]) Slice() (s []T) {
+	if l.concurrent {
+		l.mu.RLock()
+		defer l.mu.RUnlock()
+	}
+
+	s = make([]T, 0, l.len)
+	l.forEach(nil, func(_ *Node[T], val T) bool {
 		s = append(s, val)
 		return false
 	})
@@ -271,40 +498,51 @@ func (l *LinkedList) Slice() (s []GenericVal) {
 }
 
 // Val will return the value for a given node
-func (l *LinkedList) Val(n *Node) (val GenericVal) {
+func (l *LinkedList[T]) Val(n *Node[T]) (val T) {
+	if l.concurrent {
+		l.mu.RLock()
+		defer l.mu.RUnlock()
+	}
+
 	return n.val
 }
 
 // Update will update the value for a given node
-func (l *LinkedList) Update(n *Node, val GenericVal) {
+func (l *LinkedList[T]) Update(n *Node[T], val T) {
+	if l.concurrent {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+	}
+
 	n.val = val
 }
 
 // Len will return the current length of the linked list
-func (l *LinkedList) Len() (n int32) {
-	return l.len
-}
+func (l *LinkedList[T]) Len() (n int32) {
+	if l.concurrent {
+		l.mu.RLock()
+		defer l.mu.RUnlock()
+	}
 
-func newNode(prev, next *Node, val GenericVal) *Node {
-	return &Node{prev, next, val}
+	return l.len
 }
 
 // Node is a value container
-type Node struct {
-	prev *Node
-	next *Node
+type Node[T any] struct {
+	prev *Node[T]
+	next *Node[T]
 
-	val GenericVal
+	val T
 }
 
 // ForEachFn is the format of the function used to call ForEach
-type ForEachFn func(n *Node, val GenericVal) (end bool)
+type ForEachFn[T any] func(n *Node[T], val T) (end bool)
 
 // MapFn is the format of the function used to call Map
-type MapFn func(val GenericVal) (nval GenericVal)
+type MapFn[T any] func(val T) (nval T)
 
 // FilterFn is the format of the function used to call Filter
-type FilterFn func(val GenericVal) (ok bool)
+type FilterFn[T any] func(val T) (ok bool)
 
 // ReduceFn is the format of the function used to call Reduce
-type ReduceFn func(acc, val GenericVal) (sum GenericSum)
+type ReduceFn[T, S any] func(acc S, val T) (sum S)
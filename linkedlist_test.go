@@ -3,53 +3,55 @@ package linkedlist
 import (
 	"container/list"
 	"fmt"
+	"sync"
 	"testing"
-
-	intlist "github.com/itsmontoya/linkedlist/typed/int"
-	"time"
 )
 
 var (
-	testFilterVal    []GenericVal
+	testFilterVal    []int
 	testFilterIntVal []int
 )
 
 func TestLinkedList(t *testing.T) {
-	var (
-		l   LinkedList
-		err error
-	)
+	var err error
+
+	l := NewConcurrent[int]()
 
 	l.Append(0, 1, 2, 3, 4, 5, 6)
 	if l.Len() != 7 {
 		t.Fatalf("invalid length, expected %v and received %v", 7, l.Len())
 	}
 
-	if err = testIteration(&l, 0); err != nil {
+	if err = testIteration(l, 0); err != nil {
 		t.Fatal(err)
 	}
 
-	if err = testMap(&l, 0); err != nil {
+	if err = testMap(l, 0); err != nil {
 		t.Fatal(err)
 	}
 
-	if err = testFilter(&l, 0, true); err != nil {
+	if err = testFilter(l, 0, true); err != nil {
 		t.Fatal(err)
 	}
 
-	if err = testReduce(&l, 0); err != nil {
+	if err = testReduce(l, 0); err != nil {
 		t.Fatal(err)
 	}
 
-	l.ForEach(nil, func(n *Node, _ GenericVal) bool {
-		// Call a new goroutine to remove Node
-		// Node: If this is not a goroutine, it will be a deadlock
-		go l.Remove(n)
+	var wg sync.WaitGroup
+	l.ForEach(nil, func(n *Node[int], _ int) bool {
+		// The list is concurrent, so ForEach hands out a snapshot and
+		// releases its lock before calling us, letting Remove run from
+		// another goroutine without deadlocking.
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.Remove(n)
+		}()
 		return false
 	})
 
-	// Give time for goroutines to execute
-	time.Sleep(time.Millisecond * 10)
+	wg.Wait()
 
 	// Ensure that all the nodes were properly removed
 	if l.Len() != 0 {
@@ -59,21 +61,93 @@ func TestLinkedList(t *testing.T) {
 	return
 }
 
+func TestLinkedListConcurrentAppendDuringForEach(t *testing.T) {
+	l := NewConcurrent[int]()
+	l.Append(0, 1, 2)
+
+	var wg sync.WaitGroup
+	l.ForEach(nil, func(n *Node[int], val int) bool {
+		// Race a Remove of the snapshotted node against concurrent Appends,
+		// so any pooled/recycled node would surface as a length mismatch or
+		// a corrupted value below rather than a silent use-after-recycle.
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			l.Remove(n)
+		}()
+		go func() {
+			defer wg.Done()
+			l.Append(val + 100)
+		}()
+		return false
+	})
+
+	wg.Wait()
+
+	if l.Len() != 3 {
+		t.Fatalf("invalid length, expected %v and received %v", 3, l.Len())
+	}
+
+	for _, val := range l.Slice() {
+		if val < 100 {
+			t.Fatalf("expected only appended values to remain, found %d", val)
+		}
+	}
+}
+
 func TestMapFilterReduce(t *testing.T) {
-	var l LinkedList
+	var l LinkedList[int]
 	l.Append(0, 1, 2, 3, 4, 5, 6)
 
-	val := l.Map(testAddOne).Filter(testIsEven).Reduce(testAddInts)
+	val := Reduce[int, int](l.Map(testAddOne).Filter(testIsEven), testAddInts)
 	if val != 12 {
 		t.Fatalf("expected %v and received %v", 12, val)
 	}
 }
 
-func testIteration(l *LinkedList, start int) (err error) {
+func TestLinkedListBulkAppendPrepend(t *testing.T) {
+	var l LinkedList[int]
+
+	l.Append(0, 1, 2)
+	l.Prepend(3, 4, 5)
+
+	// Prepend inserts values head-first, so the last value provided ends up
+	// closest to the head - the same order repeated single Prepend calls
+	// would have produced.
+	expected := []int{5, 4, 3, 0, 1, 2}
+	got := l.Slice()
+	if len(got) != len(expected) {
+		t.Fatalf("invalid length, expected %v and received %v", len(expected), len(got))
+	}
+
+	for i, val := range expected {
+		if got[i] != val {
+			t.Fatalf("invalid value at index %d, expected %d and received %d", i, val, got[i])
+		}
+	}
+}
+
+func TestLinkedListReset(t *testing.T) {
+	var l LinkedList[int]
+	l.Append(0, 1, 2, 3, 4)
+
+	l.Reset()
+	if l.Len() != 0 {
+		t.Fatalf("invalid length, expected %v and received %v", 0, l.Len())
+	}
+
+	// The list should still be usable after a Reset
+	l.Append(5, 6)
+	if l.Len() != 2 {
+		t.Fatalf("invalid length, expected %v and received %v", 2, l.Len())
+	}
+}
+
+func testIteration(l *LinkedList[int], start int) (err error) {
 	cnt := start
 
-	l.ForEach(nil, func(_ *Node, val GenericVal) bool {
-		if val.(int) != cnt {
+	l.ForEach(nil, func(_ *Node[int], val int) bool {
+		if val != cnt {
 			err = fmt.Errorf("invalid value, expected %d and received %d", cnt, val)
 			return true
 		}
@@ -84,8 +158,8 @@ func testIteration(l *LinkedList, start int) (err error) {
 
 	cnt--
 
-	l.ForEachRev(nil, func(_ *Node, val GenericVal) bool {
-		if val.(int) != cnt {
+	l.ForEachRev(nil, func(_ *Node[int], val int) bool {
+		if val != cnt {
 			err = fmt.Errorf("invalid value, expected %d and received %d", cnt, val)
 			return true
 		}
@@ -97,9 +171,9 @@ func testIteration(l *LinkedList, start int) (err error) {
 	return
 }
 
-func testMap(l *LinkedList, start int) (err error) {
-	list := l.Map(func(val GenericVal) (nval GenericVal) {
-		nval = val.(int) * 2
+func testMap(l *LinkedList[int], start int) (err error) {
+	list := l.Map(func(val int) (nval int) {
+		nval = val * 2
 		return
 	}).Slice()
 
@@ -114,9 +188,9 @@ func testMap(l *LinkedList, start int) (err error) {
 	return
 }
 
-func testFilter(l *LinkedList, tgt int, expected bool) (err error) {
-	list := l.Filter(func(val GenericVal) (ok bool) {
-		return val.(int) == tgt
+func testFilter(l *LinkedList[int], tgt int, expected bool) (err error) {
+	list := l.Filter(func(val int) (ok bool) {
+		return val == tgt
 	}).Slice()
 
 	expectedLen := 1
@@ -131,14 +205,13 @@ func testFilter(l *LinkedList, tgt int, expected bool) (err error) {
 	return
 }
 
-func testReduce(l *LinkedList, start int) (err error) {
+func testReduce(l *LinkedList[int], start int) (err error) {
 	var cv int
 	len := int(l.Len())
-	val := l.Reduce(func(acc, val GenericVal) (sum GenericSum) {
-		accV, _ := acc.(int)
-		sum = accV + val.(int)
+	val := Reduce[int, int](l, func(acc, val int) (sum int) {
+		sum = acc + val
 		return
-	}).(int)
+	})
 
 	for i := start; i < len+start; i++ {
 		cv += i
@@ -151,23 +224,22 @@ func testReduce(l *LinkedList, start int) (err error) {
 	return
 }
 
-func testAddOne(val GenericVal) (nval GenericVal) {
-	nval = val.(int) + 1
+func testAddOne(val int) (nval int) {
+	nval = val + 1
 	return
 }
 
-func testIsEven(val GenericVal) (ok bool) {
-	return val.(int)%2 == 0
+func testIsEven(val int) (ok bool) {
+	return val%2 == 0
 }
 
-func testAddInts(acc, val GenericVal) (sum GenericSum) {
-	accV, _ := acc.(int)
-	sum = accV + val.(int)
+func testAddInts(acc, val int) (sum int) {
+	sum = acc + val
 	return
 }
 
 func BenchmarkListAppend(b *testing.B) {
-	var l LinkedList
+	var l LinkedList[int]
 	for i := 0; i < b.N; i++ {
 		l.Append(i)
 	}
@@ -175,22 +247,45 @@ func BenchmarkListAppend(b *testing.B) {
 	b.ReportAllocs()
 }
 
+func BenchmarkListAppendBulk(b *testing.B) {
+	vals := make([]int, 32)
+	for i := range vals {
+		vals[i] = i
+	}
+
+	var l LinkedList[int]
+	for i := 0; i < b.N; i++ {
+		l.Append(vals...)
+	}
+
+	b.ReportAllocs()
+}
+
+func BenchmarkListAppendRemove(b *testing.B) {
+	var l LinkedList[int]
+	for i := 0; i < b.N; i++ {
+		l.Remove(l.append(i))
+	}
+
+	b.ReportAllocs()
+}
+
 func BenchmarkListFilter(b *testing.B) {
-	var l LinkedList
+	var l LinkedList[int]
 	for i := 0; i < b.N; i++ {
 		l.Append(i)
 	}
 	b.ResetTimer()
 
-	testFilterVal = l.Filter(func(val GenericVal) bool {
-		return val.(int)%2 == 0
+	testFilterVal = l.Filter(func(val int) bool {
+		return val%2 == 0
 	}).Slice()
 
 	b.ReportAllocs()
 }
 
-func BenchmarkIntListAppend(b *testing.B) {
-	var l intlist.LinkedList
+func BenchmarkInterfaceListAppend(b *testing.B) {
+	var l LinkedList[interface{}]
 	for i := 0; i < b.N; i++ {
 		l.Append(i)
 	}
@@ -198,16 +293,20 @@ func BenchmarkIntListAppend(b *testing.B) {
 	b.ReportAllocs()
 }
 
-func BenchmarkIntListFilter(b *testing.B) {
-	var l intlist.LinkedList
+func BenchmarkInterfaceListFilter(b *testing.B) {
+	var l LinkedList[interface{}]
 	for i := 0; i < b.N; i++ {
 		l.Append(i)
 	}
 	b.ResetTimer()
 
-	testFilterIntVal = l.Filter(func(val int) bool {
-		return val%2 == 0
-	}).Slice()
+	testFilterIntVal = testFilterIntVal[:0]
+	l.Filter(func(val interface{}) bool {
+		return val.(int)%2 == 0
+	}).ForEach(nil, func(_ *Node[interface{}], val interface{}) bool {
+		testFilterIntVal = append(testFilterIntVal, val.(int))
+		return false
+	})
 
 	b.ReportAllocs()
 }
@@ -222,7 +321,7 @@ func BenchmarkStdListAppend(b *testing.B) {
 }
 
 func BenchmarkSliceAppend(b *testing.B) {
-	s := make([]GenericVal, 0, 32)
+	s := make([]int, 0, 32)
 	for i := 0; i < b.N; i++ {
 		s = append(s, i)
 	}
@@ -231,7 +330,7 @@ func BenchmarkSliceAppend(b *testing.B) {
 }
 
 func BenchmarkMapAppend(b *testing.B) {
-	s := make(map[int]GenericVal, 32)
+	s := make(map[int]int, 32)
 	for i := 0; i < b.N; i++ {
 		s[i] = i
 	}
@@ -240,7 +339,7 @@ func BenchmarkMapAppend(b *testing.B) {
 }
 
 func BenchmarkListPrepend(b *testing.B) {
-	var l LinkedList
+	var l LinkedList[int]
 	for i := 0; i < b.N; i++ {
 		l.Prepend(i)
 	}
@@ -248,8 +347,8 @@ func BenchmarkListPrepend(b *testing.B) {
 	b.ReportAllocs()
 }
 
-func BenchmarkIntListPrepend(b *testing.B) {
-	var l intlist.LinkedList
+func BenchmarkInterfaceListPrepend(b *testing.B) {
+	var l LinkedList[interface{}]
 	for i := 0; i < b.N; i++ {
 		l.Prepend(i)
 	}
@@ -267,24 +366,24 @@ func BenchmarkStdListPrepend(b *testing.B) {
 }
 
 func BenchmarkSlicePrepend(b *testing.B) {
-	s := make([]GenericVal, 0, 32)
+	s := make([]int, 0, 32)
 	for i := 0; i < b.N; i++ {
-		s = append([]GenericVal{i}, s...)
+		s = append([]int{i}, s...)
 	}
 
 	b.ReportAllocs()
 }
 
 func BenchmarkSliceFilter(b *testing.B) {
-	s := make([]GenericVal, 0, b.N)
+	s := make([]int, 0, b.N)
 	for i := 0; i < b.N; i++ {
 		s = append(s, i)
 	}
 	b.ResetTimer()
 
-	var ns []GenericVal
+	var ns []int
 	for _, val := range s {
-		if val.(int)%2 == 0 {
+		if val%2 == 0 {
 			ns = append(ns, val)
 		}
 	}
@@ -294,7 +393,7 @@ func BenchmarkSliceFilter(b *testing.B) {
 }
 
 func BenchmarkMapPrepend(b *testing.B) {
-	s := make(map[int]GenericVal, 32)
+	s := make(map[int]int, 32)
 	for i := 0; i < b.N; i++ {
 		s[i] = i
 	}
@@ -303,15 +402,15 @@ func BenchmarkMapPrepend(b *testing.B) {
 }
 
 func BenchmarkMapFilter(b *testing.B) {
-	m := make(map[int]GenericVal, b.N)
+	m := make(map[int]int, b.N)
 	for i := 0; i < b.N; i++ {
 		m[i] = i
 	}
 	b.ResetTimer()
 
-	var ns []GenericVal
+	var ns []int
 	for _, val := range m {
-		if val.(int)%2 == 0 {
+		if val%2 == 0 {
 			ns = append(ns, val)
 		}
 	}
@@ -0,0 +1,143 @@
+package linkedlist
+
+// Entry is the key/value pair stored for each OrderedMap node
+type Entry[K comparable, V any] struct {
+	Key K
+	Val V
+}
+
+// OrderedMap is a keyed collection that preserves insertion order. It
+// combines a LinkedList with a map of keys to nodes so that Set, Get,
+// Delete and Has are O(1) while iteration still walks the list in order.
+type OrderedMap[K comparable, V any] struct {
+	list  LinkedList[Entry[K, V]]
+	nodes map[K]*Node[Entry[K, V]]
+
+	// Cap is the maximum number of entries the map may hold. When Cap is
+	// greater than zero, Set will evict the oldest entry once adding a new
+	// key would otherwise exceed it.
+	Cap int
+}
+
+// NewOrderedMap will return a new OrderedMap with the provided capacity. A
+// capacity of zero means the map is unbounded.
+func NewOrderedMap[K comparable, V any](cap int) *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		nodes: make(map[K]*Node[Entry[K, V]]),
+		Cap:   cap,
+	}
+}
+
+// Set will set the value for a given key. If the key already exists, its
+// value is updated in place and its position is left unchanged; otherwise
+// the key is inserted at the back of the map. If Cap is set and adding the
+// key would exceed it, the oldest entry is evicted.
+func (om *OrderedMap[K, V]) Set(key K, val V) {
+	if om.nodes == nil {
+		om.nodes = make(map[K]*Node[Entry[K, V]])
+	}
+
+	if n, ok := om.nodes[key]; ok {
+		om.list.Update(n, Entry[K, V]{Key: key, Val: val})
+		return
+	}
+
+	om.nodes[key] = om.list.append(Entry[K, V]{Key: key, Val: val})
+	if om.Cap > 0 && len(om.nodes) > om.Cap {
+		om.evictOldest()
+	}
+}
+
+// Get will return the value for a given key
+func (om *OrderedMap[K, V]) Get(key K) (val V, ok bool) {
+	n, ok := om.nodes[key]
+	if !ok {
+		return
+	}
+
+	val = om.list.Val(n).Val
+	return
+}
+
+// Has will return whether or not a given key exists within the map
+func (om *OrderedMap[K, V]) Has(key K) (ok bool) {
+	_, ok = om.nodes[key]
+	return
+}
+
+// Delete will remove a key from the map
+func (om *OrderedMap[K, V]) Delete(key K) {
+	n, ok := om.nodes[key]
+	if !ok {
+		return
+	}
+
+	om.list.Remove(n)
+	delete(om.nodes, key)
+}
+
+// MoveToBack will move a key to the back of the map, marking it as the
+// newest entry. False is returned if the key does not exist.
+func (om *OrderedMap[K, V]) MoveToBack(key K) (ok bool) {
+	n, ok := om.nodes[key]
+	if !ok {
+		return
+	}
+
+	entry := om.list.Val(n)
+	om.list.Remove(n)
+	om.nodes[key] = om.list.append(entry)
+	return
+}
+
+// MoveToFront will move a key to the front of the map, marking it as the
+// oldest entry. False is returned if the key does not exist.
+func (om *OrderedMap[K, V]) MoveToFront(key K) (ok bool) {
+	n, ok := om.nodes[key]
+	if !ok {
+		return
+	}
+
+	entry := om.list.Val(n)
+	om.list.Remove(n)
+	om.nodes[key] = om.list.prepend(entry)
+	return
+}
+
+// Oldest will return the node for the oldest (frontmost) entry in the map
+func (om *OrderedMap[K, V]) Oldest() *Node[Entry[K, V]] {
+	return om.list.head
+}
+
+// Newest will return the node for the newest (backmost) entry in the map
+func (om *OrderedMap[K, V]) Newest() *Node[Entry[K, V]] {
+	return om.list.tail
+}
+
+// Val will return the entry for a given node
+func (om *OrderedMap[K, V]) Val(n *Node[Entry[K, V]]) Entry[K, V] {
+	return om.list.Val(n)
+}
+
+// ForEach will iterate through each entry within the map in insertion order
+func (om *OrderedMap[K, V]) ForEach(fn func(n *Node[Entry[K, V]], key K, val V) (end bool)) (ended bool) {
+	return om.list.ForEach(nil, func(n *Node[Entry[K, V]], entry Entry[K, V]) bool {
+		return fn(n, entry.Key, entry.Val)
+	})
+}
+
+// Len will return the current number of entries within the map
+func (om *OrderedMap[K, V]) Len() (n int) {
+	return len(om.nodes)
+}
+
+// evictOldest will remove the oldest (frontmost) entry from the map
+func (om *OrderedMap[K, V]) evictOldest() {
+	n := om.list.head
+	if n == nil {
+		return
+	}
+
+	delete(om.nodes, om.list.Val(n).Key)
+	om.list.Remove(n)
+}
@@ -0,0 +1,129 @@
+package linkedlist
+
+import "testing"
+
+func TestOrderedMapOrdering(t *testing.T) {
+	om := NewOrderedMap[string, int](0)
+	om.Set("a", 1)
+	om.Set("b", 2)
+	om.Set("c", 3)
+
+	if om.Len() != 3 {
+		t.Fatalf("invalid length, expected %v and received %v", 3, om.Len())
+	}
+
+	var keys []string
+	om.ForEach(func(_ *Node[Entry[string, int]], key string, _ int) bool {
+		keys = append(keys, key)
+		return false
+	})
+
+	expected := []string{"a", "b", "c"}
+	for i, key := range expected {
+		if keys[i] != key {
+			t.Fatalf("invalid key at index %d, expected %q and received %q", i, key, keys[i])
+		}
+	}
+
+	// Updating an existing key should not change its position
+	om.Set("a", 10)
+	keys = keys[:0]
+	om.ForEach(func(_ *Node[Entry[string, int]], key string, _ int) bool {
+		keys = append(keys, key)
+		return false
+	})
+
+	for i, key := range expected {
+		if keys[i] != key {
+			t.Fatalf("invalid key at index %d after update, expected %q and received %q", i, key, keys[i])
+		}
+	}
+
+	if val, ok := om.Get("a"); !ok || val != 10 {
+		t.Fatalf("invalid value, expected %v and received %v", 10, val)
+	}
+
+	if om.Val(om.Oldest()).Key != "a" {
+		t.Fatalf("invalid oldest key, expected %q and received %q", "a", om.Val(om.Oldest()).Key)
+	}
+
+	if om.Val(om.Newest()).Key != "c" {
+		t.Fatalf("invalid newest key, expected %q and received %q", "c", om.Val(om.Newest()).Key)
+	}
+
+	if !om.MoveToBack("a") {
+		t.Fatal("expected MoveToBack to succeed")
+	}
+
+	if om.Val(om.Newest()).Key != "a" {
+		t.Fatalf("invalid newest key after MoveToBack, expected %q and received %q", "a", om.Val(om.Newest()).Key)
+	}
+
+	if !om.MoveToFront("a") {
+		t.Fatal("expected MoveToFront to succeed")
+	}
+
+	if om.Val(om.Oldest()).Key != "a" {
+		t.Fatalf("invalid oldest key after MoveToFront, expected %q and received %q", "a", om.Val(om.Oldest()).Key)
+	}
+
+	om.Delete("b")
+	if om.Has("b") {
+		t.Fatal("expected key \"b\" to be deleted")
+	}
+
+	if om.Len() != 2 {
+		t.Fatalf("invalid length, expected %v and received %v", 2, om.Len())
+	}
+}
+
+func TestOrderedMapEviction(t *testing.T) {
+	om := NewOrderedMap[int, string](3)
+	om.Set(1, "a")
+	om.Set(2, "b")
+	om.Set(3, "c")
+	om.Set(4, "d")
+
+	if om.Len() != 3 {
+		t.Fatalf("invalid length, expected %v and received %v", 3, om.Len())
+	}
+
+	if om.Has(1) {
+		t.Fatal("expected key 1 to be evicted")
+	}
+
+	if om.Val(om.Oldest()).Key != 2 {
+		t.Fatalf("invalid oldest key, expected %v and received %v", 2, om.Val(om.Oldest()).Key)
+	}
+
+	if om.Val(om.Newest()).Key != 4 {
+		t.Fatalf("invalid newest key, expected %v and received %v", 4, om.Val(om.Newest()).Key)
+	}
+}
+
+func TestOrderedMapIterationDuringMutation(t *testing.T) {
+	om := NewOrderedMap[int, int](0)
+	for i := 0; i < 5; i++ {
+		om.Set(i, i)
+	}
+
+	om.ForEach(func(_ *Node[Entry[int, int]], key int, _ int) bool {
+		if key%2 == 0 {
+			om.Delete(key)
+		}
+
+		return false
+	})
+
+	if om.Len() != 2 {
+		t.Fatalf("invalid length, expected %v and received %v", 2, om.Len())
+	}
+
+	if om.Has(0) || om.Has(2) || om.Has(4) {
+		t.Fatal("expected even keys to be deleted")
+	}
+
+	if !om.Has(1) || !om.Has(3) {
+		t.Fatal("expected odd keys to remain")
+	}
+}
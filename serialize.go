@@ -0,0 +1,177 @@
+package linkedlist
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// maxEntrySize is the largest single gob-encoded value ReadFrom will accept.
+// The length prefix on the wire is untrusted input, so it is checked against
+// this ceiling before it is ever used to size an allocation.
+const maxEntrySize = 64 << 20 // 64MiB
+
+// WriteTo will write the list to w as a length-prefixed sequence of
+// length-prefixed values: a 4-byte big-endian entry count, followed by each
+// value gob-encoded and prefixed with its own 4-byte big-endian length. It
+// satisfies io.WriterTo.
+func (l *LinkedList[T]) WriteTo(w io.Writer) (n int64, err error) {
+	if l.concurrent {
+		l.mu.RLock()
+		defer l.mu.RUnlock()
+	}
+
+	cw := countWriter{w: w}
+
+	var hdr [4]byte
+	binary.BigEndian.PutUint32(hdr[:], uint32(l.len))
+	cw.write(hdr[:])
+
+	l.forEach(nil, func(_ *Node[T], val T) bool {
+		if cw.err != nil {
+			return true
+		}
+
+		var vbuf bytes.Buffer
+		if err := gob.NewEncoder(&vbuf).Encode(val); err != nil {
+			cw.err = err
+			return true
+		}
+
+		var lenBuf [4]byte
+		binary.BigEndian.PutUint32(lenBuf[:], uint32(vbuf.Len()))
+		cw.write(lenBuf[:])
+		cw.write(vbuf.Bytes())
+		return false
+	})
+
+	return cw.n, cw.err
+}
+
+// ReadFrom will replace the contents of the list with the sequence written
+// by WriteTo, read from r. It satisfies io.ReaderFrom.
+func (l *LinkedList[T]) ReadFrom(r io.Reader) (n int64, err error) {
+	if l.concurrent {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+	}
+
+	cr := countReader{r: r}
+
+	var hdr [4]byte
+	cr.read(hdr[:])
+	if cr.err != nil {
+		return cr.n, cr.err
+	}
+	count := binary.BigEndian.Uint32(hdr[:])
+
+	l.reset()
+
+	for i := uint32(0); i < count; i++ {
+		var lenBuf [4]byte
+		cr.read(lenBuf[:])
+		if cr.err != nil {
+			return cr.n, cr.err
+		}
+
+		vlen := binary.BigEndian.Uint32(lenBuf[:])
+		if vlen > maxEntrySize {
+			return cr.n, fmt.Errorf("linkedlist: encoded entry length %d exceeds maximum of %d", vlen, maxEntrySize)
+		}
+
+		vbuf := make([]byte, vlen)
+		cr.read(vbuf)
+		if cr.err != nil {
+			return cr.n, cr.err
+		}
+
+		var val T
+		if err := gob.NewDecoder(bytes.NewReader(vbuf)).Decode(&val); err != nil {
+			return cr.n, err
+		}
+
+		l.append(val)
+	}
+
+	return cr.n, nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler
+func (l *LinkedList[T]) MarshalBinary() ([]byte, error) {
+	var buf bytes.Buffer
+	if _, err := l.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler
+func (l *LinkedList[T]) UnmarshalBinary(data []byte) error {
+	_, err := l.ReadFrom(bytes.NewReader(data))
+	return err
+}
+
+// MarshalJSON implements json.Marshaler
+func (l *LinkedList[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Slice())
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (l *LinkedList[T]) UnmarshalJSON(data []byte) error {
+	var vals []T
+	if err := json.Unmarshal(data, &vals); err != nil {
+		return err
+	}
+
+	if l.concurrent {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+	}
+
+	l.reset()
+	if len(vals) > 0 {
+		l.appendBulk(vals)
+	}
+
+	return nil
+}
+
+// countWriter wraps an io.Writer, tallying the bytes written and latching
+// the first error so callers can perform several writes and check once
+type countWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (cw *countWriter) write(p []byte) {
+	if cw.err != nil {
+		return
+	}
+
+	var nn int
+	nn, cw.err = cw.w.Write(p)
+	cw.n += int64(nn)
+}
+
+// countReader wraps an io.Reader, tallying the bytes read and latching the
+// first error so callers can perform several reads and check once
+type countReader struct {
+	r   io.Reader
+	n   int64
+	err error
+}
+
+func (cr *countReader) read(p []byte) {
+	if cr.err != nil {
+		return
+	}
+
+	var nn int
+	nn, cr.err = io.ReadFull(cr.r, p)
+	cr.n += int64(nn)
+}
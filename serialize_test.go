@@ -0,0 +1,87 @@
+package linkedlist
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestLinkedListBinaryRoundTrip(t *testing.T) {
+	var l LinkedList[int]
+	l.Append(0, 1, 2, 3, 4, 5, 6)
+
+	data, err := l.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var nl LinkedList[int]
+	if err = nl.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if nl.Len() != l.Len() {
+		t.Fatalf("invalid length, expected %v and received %v", l.Len(), nl.Len())
+	}
+
+	expected, got := l.Slice(), nl.Slice()
+	for i, val := range expected {
+		if got[i] != val {
+			t.Fatalf("invalid value at index %d, expected %d and received %d", i, val, got[i])
+		}
+	}
+}
+
+func TestLinkedListUnmarshalBinaryCorruptLength(t *testing.T) {
+	// One entry declared, whose length prefix claims an implausibly large
+	// value while the buffer holds only a handful of trailing bytes.
+	data := make([]byte, 8)
+	binary.BigEndian.PutUint32(data[0:4], 1)
+	binary.BigEndian.PutUint32(data[4:8], 0x7fffffff)
+	data = append(data, []byte("short")...)
+
+	var l LinkedList[int]
+	if err := l.UnmarshalBinary(data); err == nil {
+		t.Fatal("expected an error for an oversized entry length, received nil")
+	}
+}
+
+func TestLinkedListUnmarshalBinaryTruncated(t *testing.T) {
+	var src LinkedList[int]
+	src.Append(1, 2, 3)
+
+	data, err := src.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var l LinkedList[int]
+	if err = l.UnmarshalBinary(data[:len(data)-2]); err == nil {
+		t.Fatal("expected an error for a truncated stream, received nil")
+	}
+}
+
+func TestLinkedListJSONRoundTrip(t *testing.T) {
+	var l LinkedList[string]
+	l.Append("a", "b", "c")
+
+	data, err := l.MarshalJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var nl LinkedList[string]
+	if err = nl.UnmarshalJSON(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if nl.Len() != l.Len() {
+		t.Fatalf("invalid length, expected %v and received %v", l.Len(), nl.Len())
+	}
+
+	expected, got := l.Slice(), nl.Slice()
+	for i, val := range expected {
+		if got[i] != val {
+			t.Fatalf("invalid value at index %d, expected %q and received %q", i, val, got[i])
+		}
+	}
+}